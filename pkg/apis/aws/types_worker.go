@@ -0,0 +1,199 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aws
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// WorkerConfig contains configuration settings for the worker nodes.
+type WorkerConfig struct {
+	metav1.TypeMeta
+
+	// NodeTemplate contains resource information of the machine which is used by Cluster Autoscaler to
+	// generate nodeTemplate during scaling a nodeGroup from zero.
+	NodeTemplate *extensionsv1alpha1.NodeTemplate
+
+	// Volume contains configuration for the root disks attached to VMs.
+	Volume *Volume
+
+	// DataVolumes contains configuration for the additional disks attached to VMs.
+	DataVolumes []DataVolume
+
+	// IAMInstanceProfile contains configuration for the IAM instance profile that should be used for the worker pool.
+	IAMInstanceProfile *IAMInstanceProfile
+
+	// InstanceMetadataOptions contains configuration for the instance metadata service (IMDS) of the worker pool.
+	InstanceMetadataOptions *InstanceMetadataOptions
+
+	// Provisioner selects the backend used to provision nodes for this worker pool. If empty, ProvisionerMCM is
+	// assumed.
+	Provisioner *ProvisionerType
+
+	// InstanceTypeOptions configures alternative EC2 instance types and purchasing behavior for this worker pool,
+	// in addition to the pool's primary MachineType.
+	InstanceTypeOptions *InstanceTypeOptions
+
+	// PlacementGroup configures an EC2 placement group that instances in this worker pool are launched into.
+	PlacementGroup *PlacementGroup
+
+	// CapacityReservationTarget targets an existing EC2 Capacity Reservation or Capacity Reservation resource
+	// group that instances in this worker pool should be launched into.
+	CapacityReservationTarget *CapacityReservationTarget
+}
+
+// PlacementGroup configures an EC2 placement group.
+type PlacementGroup struct {
+	// Name is the name of the placement group.
+	Name string
+	// Strategy is the placement strategy. Defaults to PlacementGroupStrategyCluster.
+	Strategy *PlacementGroupStrategy
+	// Partitions is the number of partitions to spread instances across. Only evaluated when Strategy is
+	// PlacementGroupStrategyPartition.
+	Partitions *int32
+}
+
+// PlacementGroupStrategy is the strategy used to place instances within a placement group.
+type PlacementGroupStrategy string
+
+const (
+	// PlacementGroupStrategyCluster packs instances close together inside a single availability zone.
+	PlacementGroupStrategyCluster PlacementGroupStrategy = "cluster"
+	// PlacementGroupStrategySpread strictly places each instance on distinct underlying hardware. AWS limits
+	// spread placement groups to 7 running instances per availability zone.
+	PlacementGroupStrategySpread PlacementGroupStrategy = "spread"
+	// PlacementGroupStrategyPartition divides instances into logical partitions that do not share underlying
+	// hardware with other partitions.
+	PlacementGroupStrategyPartition PlacementGroupStrategy = "partition"
+)
+
+// CapacityReservationTarget targets an existing EC2 Capacity Reservation or Capacity Reservation resource group
+// that a worker pool's instances should be launched into.
+type CapacityReservationTarget struct {
+	// CapacityReservationID is the ID of an existing, already created capacity reservation.
+	CapacityReservationID *string
+	// CapacityReservationResourceGroupARN is the ARN of a resource group of capacity reservations.
+	CapacityReservationResourceGroupARN *string
+	// Preference indicates whether instances may fall back to on-demand capacity if the targeted reservation is
+	// unavailable. Defaults to CapacityReservationPreferenceOpen.
+	Preference *CapacityReservationPreference
+}
+
+// CapacityReservationPreference indicates whether a worker pool's instances may fall back to on-demand capacity.
+type CapacityReservationPreference string
+
+const (
+	// CapacityReservationPreferenceOpen allows instances to fall back to unreserved on-demand capacity.
+	CapacityReservationPreferenceOpen CapacityReservationPreference = "open"
+	// CapacityReservationPreferenceNone requires instances to run only in the targeted reservation.
+	CapacityReservationPreferenceNone CapacityReservationPreference = "none"
+)
+
+// InstanceTypeOptions configures alternative EC2 instance types and purchasing behavior for a worker pool.
+type InstanceTypeOptions struct {
+	// Types lists alternative EC2 instance types that may be used in addition to the pool's primary MachineType.
+	Types []string
+	// PurchaseOption selects how instances are purchased. Defaults to PurchaseOptionOnDemand.
+	PurchaseOption *PurchaseOptionType
+	// AllocationStrategy selects how instances are distributed across the available types and zones when more
+	// than one instance type is configured. Defaults to AllocationStrategyLowestPrice.
+	AllocationStrategy *AllocationStrategyType
+	// SpotOptions configures spot-specific behavior. Only evaluated when PurchaseOption is PurchaseOptionSpot.
+	SpotOptions *SpotOptions
+}
+
+// PurchaseOptionType is the way in which EC2 instances for a worker pool are purchased.
+type PurchaseOptionType string
+
+const (
+	// PurchaseOptionOnDemand purchases on-demand instances.
+	PurchaseOptionOnDemand PurchaseOptionType = "on-demand"
+	// PurchaseOptionSpot purchases spot instances.
+	PurchaseOptionSpot PurchaseOptionType = "spot"
+	// PurchaseOptionCapacityBlock consumes a pre-purchased EC2 Capacity Block.
+	PurchaseOptionCapacityBlock PurchaseOptionType = "capacity-block"
+)
+
+// AllocationStrategyType determines how instances are distributed across a worker pool's instance types and zones.
+type AllocationStrategyType string
+
+const (
+	// AllocationStrategyLowestPrice allocates instances from the pool's cheapest available type/zone combination.
+	AllocationStrategyLowestPrice AllocationStrategyType = "lowest-price"
+	// AllocationStrategyCapacityOptimized allocates instances from the type/zone combination with the most
+	// available capacity, to minimize interruptions.
+	AllocationStrategyCapacityOptimized AllocationStrategyType = "capacity-optimized"
+	// AllocationStrategyPriceCapacityOptimized balances capacity availability and price.
+	AllocationStrategyPriceCapacityOptimized AllocationStrategyType = "price-capacity-optimized"
+)
+
+// SpotOptions configures spot instance behavior for a worker pool.
+type SpotOptions struct {
+	// MaxPrice is the maximum price per hour an operator is willing to pay for a spot instance. If unset, the
+	// on-demand price is used as the cap.
+	MaxPrice *string
+	// InterruptionBehavior determines what happens to an instance when it is interrupted. One of "terminate",
+	// "stop" or "hibernate". Defaults to "terminate".
+	InterruptionBehavior *string
+}
+
+// ProvisionerType is the backend that provisions and manages nodes for a worker pool.
+type ProvisionerType string
+
+const (
+	// ProvisionerMCM provisions nodes via machine-controller-manager MachineClasses and MachineDeployments.
+	ProvisionerMCM ProvisionerType = "mcm"
+	// ProvisionerKarpenter provisions nodes via Karpenter EC2NodeClasses and NodePools.
+	ProvisionerKarpenter ProvisionerType = "karpenter"
+)
+
+// Volume contains configuration for the root disks attached to VMs.
+type Volume struct {
+	// IOPS is the number of IOPS provisioned for the root disk.
+	IOPS *int64
+	// Throughput is the throughput in MiB/s provisioned for the root disk.
+	Throughput *int64
+}
+
+// DataVolume contains configuration for additional disks attached to VMs.
+type DataVolume struct {
+	// Name is the name of the data volume this configuration applies to.
+	Name string
+	// IOPS is the number of IOPS provisioned for the volume.
+	IOPS *int64
+	// Throughput is the throughput in MiB/s provisioned for the volume.
+	Throughput *int64
+	// SnapshotID is the ID of the snapshot the volume should be restored from.
+	SnapshotID *string
+}
+
+// IAMInstanceProfile contains configuration for the IAM instance profile that should be used for a worker pool.
+type IAMInstanceProfile struct {
+	// Name is the name of an existing IAM instance profile.
+	Name *string
+	// ARN is the ARN of an existing IAM instance profile.
+	ARN *string
+}
+
+// InstanceMetadataOptions contains configuration for the instance metadata service (IMDS) of a worker pool.
+type InstanceMetadataOptions struct {
+	// HTTPTokens determines whether the use of IMDSv2 tokens is required.
+	HTTPTokens *HTTPTokensValue
+	// HTTPPutResponseHopLimit is the desired HTTP PUT response hop limit for instance metadata requests.
+	HTTPPutResponseHopLimit *int64
+}
+
+// HTTPTokensValue describes whether the use of IMDSv2 tokens is required.
+type HTTPTokensValue string
+
+const (
+	// HTTPTokensRequired requires the use of IMDSv2 tokens.
+	HTTPTokensRequired HTTPTokensValue = "required"
+	// HTTPTokensOptional allows the use of both IMDSv1 and IMDSv2.
+	HTTPTokensOptional HTTPTokensValue = "optional"
+)
@@ -0,0 +1,51 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation
+
+import (
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// maxInstancesPerZoneInSpreadPlacementGroup is the AWS limit on the number of running instances a spread
+// placement group may have per availability zone.
+const maxInstancesPerZoneInSpreadPlacementGroup = 7
+
+// ValidateWorkerConfig validates a WorkerConfig against the WorkerPool it belongs to.
+//
+// This extension has no admission webhook for Worker resources, so the call site in
+// pkg/controller/worker.generateMachineConfig enforces this at reconcile time rather than at admission; a Worker
+// that already exists with an invalid config will fail reconciliation instead of being rejected up front.
+func ValidateWorkerConfig(workerConfig *awsapi.WorkerConfig, pool extensionsv1alpha1.WorkerPool, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if workerConfig == nil {
+		return allErrs
+	}
+
+	if pg := workerConfig.PlacementGroup; pg != nil {
+		pgPath := fldPath.Child("placementGroup")
+
+		if pg.Strategy != nil && *pg.Strategy == awsapi.PlacementGroupStrategySpread {
+			zoneLen := int32(len(pool.Zones))
+			if zoneLen == 0 {
+				zoneLen = 1
+			}
+
+			maxPerZone := pool.Maximum / zoneLen
+			if pool.Maximum%zoneLen != 0 {
+				maxPerZone++
+			}
+
+			if maxPerZone > maxInstancesPerZoneInSpreadPlacementGroup {
+				allErrs = append(allErrs, field.Invalid(pgPath.Child("strategy"), *pg.Strategy, "worker pool's maximum would exceed the AWS limit of 7 running instances per availability zone for a spread placement group"))
+			}
+		}
+	}
+
+	return allErrs
+}
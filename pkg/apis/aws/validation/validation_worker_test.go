@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package validation_test
+
+import (
+	"testing"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	. "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+)
+
+func TestValidateWorkerConfig(t *testing.T) {
+	spread := awsapi.PlacementGroupStrategySpread
+
+	tests := []struct {
+		name     string
+		pool     extensionsv1alpha1.WorkerPool
+		wantErrs int
+	}{
+		{
+			name: "at the 7-per-zone limit",
+			pool: extensionsv1alpha1.WorkerPool{
+				Zones:   []string{"eu-west-1a", "eu-west-1b"},
+				Maximum: 14,
+			},
+			wantErrs: 0,
+		},
+		{
+			name: "one over the 7-per-zone limit",
+			pool: extensionsv1alpha1.WorkerPool{
+				Zones:   []string{"eu-west-1a", "eu-west-1b"},
+				Maximum: 15,
+			},
+			wantErrs: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			workerConfig := &awsapi.WorkerConfig{
+				PlacementGroup: &awsapi.PlacementGroup{
+					Name:     "my-placement-group",
+					Strategy: &spread,
+				},
+			}
+
+			errs := ValidateWorkerConfig(workerConfig, tt.pool, field.NewPath("providerConfig"))
+			if len(errs) != tt.wantErrs {
+				t.Errorf("ValidateWorkerConfig() = %v, want %d error(s)", errs, tt.wantErrs)
+			}
+		})
+	}
+
+	t.Run("nil workerConfig is valid", func(t *testing.T) {
+		if errs := ValidateWorkerConfig(nil, extensionsv1alpha1.WorkerPool{}, field.NewPath("providerConfig")); len(errs) != 0 {
+			t.Errorf("ValidateWorkerConfig() = %v, want no errors", errs)
+		}
+	})
+}
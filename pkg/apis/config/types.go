@@ -0,0 +1,53 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// ControllerConfiguration defines the configuration for the AWS provider extension.
+type ControllerConfiguration struct {
+	metav1.TypeMeta
+
+	// WorkerDefaults contains default settings applied to worker pools unless overridden by their WorkerConfig.
+	WorkerDefaults *WorkerDefaults
+}
+
+// WorkerDefaults contains default settings applied to worker pools.
+type WorkerDefaults struct {
+	// InstanceMetadata configures the default instance metadata service (IMDS) policy applied to worker pools
+	// that don't set their own InstanceMetadataOptions.
+	InstanceMetadata *InstanceMetadataDefaults
+}
+
+// InstanceMetadataDefaults configures the cluster-wide default IMDSv2 enforcement policy for worker pools.
+type InstanceMetadataDefaults struct {
+	// MinKubernetesVersion is the minimum Shoot Kubernetes version constraint (e.g. ">= 1.30-0") from which the
+	// default policy is enforced. If unset, the policy is enforced for Shoots with Kubernetes version ">= 1.30-0".
+	MinKubernetesVersion *string
+	// DefaultHTTPTokens is the default value for httpTokens applied to worker pools that don't set
+	// WorkerConfig.InstanceMetadataOptions. Defaults to "required".
+	DefaultHTTPTokens *awsapi.HTTPTokensValue
+	// DefaultHopLimit is the default value for httpPutResponseHopLimit applied to worker pools that don't set
+	// WorkerConfig.InstanceMetadataOptions. Defaults to 2.
+	DefaultHopLimit *int64
+	// AllowOptOutFor lists worker pools that are permitted to opt out of the enforced policy by setting
+	// WorkerConfig.InstanceMetadataOptions.HTTPTokens to "optional". Pools not matched by any selector in this
+	// list that attempt to do so fail reconciliation of their Worker.
+	AllowOptOutFor []WorkerOptOutSelector
+}
+
+// WorkerOptOutSelector matches worker pools permitted to opt out of the enforced IMDSv2 policy.
+type WorkerOptOutSelector struct {
+	// PoolName, if set, matches a worker pool with this exact name.
+	PoolName *string
+	// PoolLabels, if set, matches worker pools whose labels are a superset of these.
+	PoolLabels map[string]string
+}
@@ -0,0 +1,84 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	"github.com/gardener/gardener/extensions/pkg/controller/worker"
+	genericactuator "github.com/gardener/gardener/extensions/pkg/controller/worker/genericactuator"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	providerconfig "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
+)
+
+// workerDelegate implements genericactuator.WorkerDelegate for the AWS provider.
+type workerDelegate struct {
+	client           client.Client
+	seedChartApplier kubernetes.ChartApplier
+	decoder          runtime.Decoder
+
+	worker  *extensionsv1alpha1.Worker
+	cluster *controller.Cluster
+
+	// workerDefaults holds the extension's ControllerConfiguration-level defaults applied to worker pools, such
+	// as the cluster-wide IMDSv2 enforcement policy.
+	workerDefaults *providerconfig.WorkerDefaults
+
+	machineClasses     []map[string]interface{}
+	machineDeployments worker.MachineDeployments
+	machineImages      []awsapi.MachineImage
+
+	// ec2NodeClasses and nodePools hold the Karpenter resources generated by generateMachineConfig for worker
+	// pools that opted into the Karpenter provisioner, in place of machineClasses/machineDeployments.
+	ec2NodeClasses []map[string]interface{}
+	nodePools      []map[string]interface{}
+}
+
+// delegateFactory builds workerDelegates, carrying the dependencies every delegate needs.
+type delegateFactory struct {
+	client         client.Client
+	decoder        runtime.Decoder
+	restConfig     *rest.Config
+	workerDefaults *providerconfig.WorkerDefaults
+}
+
+// WorkerDelegate creates a new workerDelegate for the given Worker and Cluster.
+func (d *delegateFactory) WorkerDelegate(_ context.Context, w *extensionsv1alpha1.Worker, cluster *controller.Cluster) (genericactuator.WorkerDelegate, error) {
+	seedChartApplier, err := kubernetes.NewChartApplierForConfig(d.restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return &workerDelegate{
+		client:           d.client,
+		seedChartApplier: seedChartApplier,
+		decoder:          d.decoder,
+		worker:           w,
+		cluster:          cluster,
+		workerDefaults:   d.workerDefaults,
+	}, nil
+}
+
+// NewActuator creates a new Actuator that reconciles Worker resources for the AWS provider.
+func NewActuator(mgr manager.Manager, workerDefaults *providerconfig.WorkerDefaults) (worker.Actuator, error) {
+	return genericactuator.NewActuator(
+		mgr,
+		&delegateFactory{
+			client:         mgr.GetClient(),
+			decoder:        serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
+			restConfig:     mgr.GetConfig(),
+			workerDefaults: workerDefaults,
+		},
+	)
+}
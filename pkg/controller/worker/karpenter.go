@@ -0,0 +1,161 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/client/kubernetes"
+
+	"github.com/gardener/gardener-extension-provider-aws/charts"
+	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
+	awsapihelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+)
+
+// isKarpenterProvisioner returns true if the given worker pool is configured to be provisioned by Karpenter
+// instead of the machine-controller-manager.
+func isKarpenterProvisioner(workerConfig *awsapi.WorkerConfig) bool {
+	return workerConfig != nil && workerConfig.Provisioner != nil && *workerConfig.Provisioner == awsapi.ProvisionerKarpenter
+}
+
+// deployKarpenterResources applies the chart that installs the Karpenter controller (CRDs, RBAC, and Deployment)
+// into the shoot's control plane, together with the EC2NodeClass and NodePool objects collected by
+// generateMachineConfig.
+func (w *workerDelegate) deployKarpenterResources(ctx context.Context) error {
+	if len(w.ec2NodeClasses) == 0 && len(w.nodePools) == 0 {
+		return nil
+	}
+
+	return w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join(charts.InternalChartsPath, "karpenter"), w.worker.Namespace, "karpenter", kubernetes.Values(map[string]interface{}{
+		"controller": map[string]interface{}{
+			"enabled": true,
+		},
+		"ec2NodeClasses": w.ec2NodeClasses,
+		"nodePools":      w.nodePools,
+	}))
+}
+
+// generateKarpenterResources translates a single WorkerPool into the spec of a Karpenter EC2NodeClass and NodePool.
+func (w *workerDelegate) generateKarpenterResources(
+	pool extensionsv1alpha1.WorkerPool,
+	workerConfig *awsapi.WorkerConfig,
+	infrastructureStatus *awsapi.InfrastructureStatus,
+	ami string,
+	iamInstanceProfile map[string]interface{},
+	blockDevices []map[string]interface{},
+	instanceMetadataOptions map[string]interface{},
+	userData []byte,
+) (map[string]interface{}, map[string]interface{}, error) {
+	nodesSecurityGroup, err := awsapihelper.FindSecurityGroupForPurpose(infrastructureStatus.VPC.SecurityGroups, awsapi.PurposeNodes)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instanceProfileName, err := karpenterInstanceProfileName(iamInstanceProfile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	subnetSelectorTerms := make([]map[string]interface{}, 0, len(pool.Zones))
+	for _, zone := range pool.Zones {
+		nodesSubnet, err := awsapihelper.FindSubnetForPurposeAndZone(infrastructureStatus.VPC.Subnets, awsapi.PurposeNodes, zone)
+		if err != nil {
+			return nil, nil, err
+		}
+		subnetSelectorTerms = append(subnetSelectorTerms, map[string]interface{}{"id": nodesSubnet.ID})
+	}
+
+	nodeClassName := fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name)
+
+	ec2NodeClass := map[string]interface{}{
+		"name":                       nodeClassName,
+		"amiSelectorTerms":           []map[string]interface{}{{"id": ami}},
+		"subnetSelectorTerms":        subnetSelectorTerms,
+		"securityGroupSelectorTerms": []map[string]interface{}{{"id": nodesSecurityGroup.ID}},
+		"instanceProfile":            instanceProfileName,
+		"blockDeviceMappings":        translateBlockDevicesForKarpenter(blockDevices),
+		"metadataOptions":            translateInstanceMetadataOptionsForKarpenter(instanceMetadataOptions),
+		"userData":                   string(userData),
+	}
+
+	nodePool := map[string]interface{}{
+		"name":   fmt.Sprintf("%s-%s", w.worker.Namespace, pool.Name),
+		"labels": pool.Labels,
+		"taints": pool.Taints,
+		"requirements": []map[string]interface{}{
+			{
+				"key":      "topology.kubernetes.io/zone",
+				"operator": "In",
+				"values":   pool.Zones,
+			},
+		},
+		"nodeClassRef": map[string]interface{}{
+			"name": nodeClassName,
+		},
+		"limits": map[string]interface{}{
+			"minimum": pool.Minimum,
+			"maximum": pool.Maximum,
+		},
+	}
+
+	return ec2NodeClass, nodePool, nil
+}
+
+// karpenterInstanceProfileName resolves the IAM instance profile name for a Karpenter EC2NodeClass, which unlike
+// an MCM MachineClass has no field to reference a profile by ARN.
+func karpenterInstanceProfileName(iamInstanceProfile map[string]interface{}) (string, error) {
+	if name, ok := iamInstanceProfile["name"].(string); ok {
+		return name, nil
+	}
+
+	return "", fmt.Errorf("worker pools provisioned by Karpenter must reference the IAM instance profile by name, not ARN")
+}
+
+// translateBlockDevicesForKarpenter converts the MCM-shaped block device mappings computed by computeBlockDevices
+// into the shape expected by Karpenter's EC2NodeClass: the root disk is marked via "rootVolume" instead of the
+// placeholder "/root" device name MCM uses, and volumeSize is expressed as a Kubernetes quantity string rather
+// than a raw gibibyte count.
+func translateBlockDevicesForKarpenter(blockDevices []map[string]interface{}) []map[string]interface{} {
+	translated := make([]map[string]interface{}, 0, len(blockDevices))
+
+	for i, blockDevice := range blockDevices {
+		ebs, _ := blockDevice["ebs"].(map[string]interface{})
+		translatedEBS := make(map[string]interface{}, len(ebs))
+		for k, v := range ebs {
+			translatedEBS[k] = v
+		}
+		switch volumeSize := ebs["volumeSize"].(type) {
+		case int:
+			translatedEBS["volumeSize"] = fmt.Sprintf("%dGi", volumeSize)
+		case int64:
+			translatedEBS["volumeSize"] = fmt.Sprintf("%dGi", volumeSize)
+		}
+
+		translatedDevice := map[string]interface{}{"ebs": translatedEBS}
+		if i == 0 {
+			translatedDevice["rootVolume"] = true
+		} else if deviceName, ok := blockDevice["deviceName"]; ok {
+			translatedDevice["deviceName"] = deviceName
+		}
+
+		translated = append(translated, translatedDevice)
+	}
+
+	return translated
+}
+
+// translateInstanceMetadataOptionsForKarpenter converts the instance metadata options computed by
+// ComputeInstanceMetadata into Karpenter's EC2NodeClass metadataOptions shape. httpTokens and
+// httpPutResponseHopLimit already match; Karpenter additionally requires httpEndpoint to be set explicitly.
+func translateInstanceMetadataOptionsForKarpenter(instanceMetadataOptions map[string]interface{}) map[string]interface{} {
+	translated := map[string]interface{}{"httpEndpoint": "enabled"}
+	for k, v := range instanceMetadataOptions {
+		translated[k] = v
+	}
+	return translated
+}
@@ -0,0 +1,67 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import "testing"
+
+func TestTranslateBlockDevicesForKarpenter(t *testing.T) {
+	blockDevices := []map[string]interface{}{
+		{
+			"ebs": map[string]interface{}{
+				"volumeSize":          int64(50),
+				"volumeType":          "gp3",
+				"encrypted":           true,
+				"deleteOnTermination": true,
+			},
+		},
+		{
+			"deviceName": "/dev/sdf",
+			"ebs": map[string]interface{}{
+				"volumeSize": int64(100),
+			},
+		},
+	}
+
+	translated := translateBlockDevicesForKarpenter(blockDevices)
+	if len(translated) != 2 {
+		t.Fatalf("translateBlockDevicesForKarpenter() returned %d devices, want 2", len(translated))
+	}
+
+	root := translated[0]
+	if rootVolume, _ := root["rootVolume"].(bool); !rootVolume {
+		t.Errorf("root device: rootVolume = %v, want true", root["rootVolume"])
+	}
+	if _, ok := root["deviceName"]; ok {
+		t.Errorf("root device: deviceName = %v, want unset", root["deviceName"])
+	}
+	if volumeSize := root["ebs"].(map[string]interface{})["volumeSize"]; volumeSize != "50Gi" {
+		t.Errorf("root device: ebs.volumeSize = %v, want %q", volumeSize, "50Gi")
+	}
+
+	dataDevice := translated[1]
+	if dataDevice["deviceName"] != "/dev/sdf" {
+		t.Errorf("data device: deviceName = %v, want %q", dataDevice["deviceName"], "/dev/sdf")
+	}
+	if volumeSize := dataDevice["ebs"].(map[string]interface{})["volumeSize"]; volumeSize != "100Gi" {
+		t.Errorf("data device: ebs.volumeSize = %v, want %q", volumeSize, "100Gi")
+	}
+}
+
+func TestTranslateInstanceMetadataOptionsForKarpenter(t *testing.T) {
+	translated := translateInstanceMetadataOptionsForKarpenter(map[string]interface{}{
+		"httpTokens":              "required",
+		"httpPutResponseHopLimit": int64(2),
+	})
+
+	if translated["httpEndpoint"] != "enabled" {
+		t.Errorf("httpEndpoint = %v, want %q", translated["httpEndpoint"], "enabled")
+	}
+	if translated["httpTokens"] != "required" {
+		t.Errorf("httpTokens = %v, want %q", translated["httpTokens"], "required")
+	}
+	if translated["httpPutResponseHopLimit"] != int64(2) {
+		t.Errorf("httpPutResponseHopLimit = %v, want %d", translated["httpPutResponseHopLimit"], 2)
+	}
+}
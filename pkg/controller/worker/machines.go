@@ -10,6 +10,7 @@ import (
 	"path/filepath"
 	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/Masterminds/semver/v3"
 	"github.com/gardener/gardener/extensions/pkg/controller"
@@ -23,12 +24,15 @@ import (
 	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-aws/charts"
 	awsapi "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws"
 	awsapihelper "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/helper"
+	awsvalidation "github.com/gardener/gardener-extension-provider-aws/pkg/apis/aws/validation"
+	providerconfig "github.com/gardener/gardener-extension-provider-aws/pkg/apis/config"
 )
 
 var (
@@ -58,20 +62,30 @@ func (w *workerDelegate) MachineClass() client.Object {
 	return &machinev1alpha1.MachineClass{}
 }
 
-// DeployMachineClasses generates and creates the AWS specific machine classes.
+// DeployMachineClasses generates and creates the AWS specific machine classes, as well as the Karpenter
+// EC2NodeClasses/NodePools for worker pools that opted into the Karpenter provisioner.
 func (w *workerDelegate) DeployMachineClasses(ctx context.Context) error {
-	if w.machineClasses == nil {
+	if w.machineClasses == nil && w.ec2NodeClasses == nil {
 		if err := w.generateMachineConfig(ctx); err != nil {
 			return err
 		}
 	}
 
+	if err := w.deployKarpenterResources(ctx); err != nil {
+		return err
+	}
+
+	if len(w.machineClasses) == 0 {
+		return nil
+	}
+
 	return w.seedChartApplier.ApplyFromEmbeddedFS(ctx, charts.InternalChart, filepath.Join(charts.InternalChartsPath, "machineclass"), w.worker.Namespace, "machineclass", kubernetes.Values(map[string]interface{}{"machineClasses": w.machineClasses}))
 }
 
-// GenerateMachineDeployments generates the configuration for the desired machine deployments.
+// GenerateMachineDeployments generates the configuration for the desired machine deployments. Worker pools
+// provisioned by Karpenter do not get a MachineDeployment, since Karpenter manages their nodes directly.
 func (w *workerDelegate) GenerateMachineDeployments(ctx context.Context) (worker.MachineDeployments, error) {
-	if w.machineDeployments == nil {
+	if w.machineDeployments == nil && w.ec2NodeClasses == nil {
 		if err := w.generateMachineConfig(ctx); err != nil {
 			return nil, err
 		}
@@ -84,6 +98,8 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		machineDeployments = worker.MachineDeployments{}
 		machineClasses     []map[string]interface{}
 		machineImages      []awsapi.MachineImage
+		ec2NodeClasses     []map[string]interface{}
+		nodePools          []map[string]interface{}
 	)
 
 	infrastructureStatus := &awsapi.InfrastructureStatus{}
@@ -96,7 +112,7 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 		return err
 	}
 
-	for _, pool := range w.worker.Spec.Pools {
+	for poolIdx, pool := range w.worker.Spec.Pools {
 		zoneLen := int32(len(pool.Zones))
 
 		workerConfig := &awsapi.WorkerConfig{}
@@ -106,7 +122,12 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			}
 		}
 
-		workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, computeAdditionalHashData(pool)...)
+		poolPath := field.NewPath("spec", "pools").Index(poolIdx).Child("providerConfig")
+		if errs := awsvalidation.ValidateWorkerConfig(workerConfig, pool, poolPath); len(errs) > 0 {
+			return errs.ToAggregate()
+		}
+
+		workerPoolHash, err := worker.WorkerPoolHash(pool, w.cluster, computeAdditionalHashData(pool, workerConfig)...)
 		if err != nil {
 			return err
 		}
@@ -124,17 +145,12 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			Architecture: &arch,
 		})
 
-		blockDevices, err := w.computeBlockDevices(pool, workerConfig)
-		if err != nil {
-			return err
-		}
-
 		iamInstanceProfile, err := computeIAMInstanceProfile(workerConfig, infrastructureStatus)
 		if err != nil {
 			return err
 		}
 
-		instanceMetadataOptions, err := ComputeInstanceMetadata(workerConfig, w.cluster)
+		instanceMetadataOptions, err := ComputeInstanceMetadata(workerConfig, pool, w.cluster, w.workerDefaults)
 		if err != nil {
 			return err
 		}
@@ -144,6 +160,27 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 			return err
 		}
 
+		if isKarpenterProvisioner(workerConfig) {
+			// Karpenter generates a single EC2NodeClass per pool, so mixed legacy-Xen/Nitro instance type
+			// options aren't supported here; block devices are computed for the pool's primary MachineType.
+			blockDevices, err := w.computeBlockDevices(pool, workerConfig, pool.MachineType)
+			if err != nil {
+				return err
+			}
+
+			ec2NodeClass, nodePool, err := w.generateKarpenterResources(pool, workerConfig, infrastructureStatus, ami, iamInstanceProfile, blockDevices, instanceMetadataOptions, userData)
+			if err != nil {
+				return err
+			}
+			ec2NodeClasses = append(ec2NodeClasses, ec2NodeClass)
+			nodePools = append(nodePools, nodePool)
+			continue
+		}
+
+		instanceTypes := computeInstanceTypes(pool, workerConfig)
+		typeLen := int32(len(instanceTypes))
+		spotOptions := computeSpotOptions(workerConfig)
+
 		for zoneIndex, zone := range pool.Zones {
 			zoneIdx := int32(zoneIndex)
 
@@ -152,103 +189,147 @@ func (w *workerDelegate) generateMachineConfig(ctx context.Context) error {
 				return err
 			}
 
-			machineClassSpec := map[string]interface{}{
-				"ami":                ami,
-				"region":             w.worker.Spec.Region,
-				"machineType":        pool.MachineType,
-				"iamInstanceProfile": iamInstanceProfile,
-				"networkInterfaces": []map[string]interface{}{
-					{
-						"subnetID":         nodesSubnet.ID,
-						"securityGroupIDs": []string{nodesSecurityGroup.ID},
+			zoneMinimum := worker.DistributeOverZones(zoneIdx, pool.Minimum, zoneLen)
+			zoneMaximum := worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen)
+			zoneMaxSurge := worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum)
+			zoneMaxUnavailable := worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum)
+
+			for typeIndex, instanceType := range instanceTypes {
+				typeIdx := int32(typeIndex)
+
+				blockDevices, err := w.computeBlockDevices(pool, workerConfig, instanceType)
+				if err != nil {
+					return err
+				}
+
+				machineClassSpec := map[string]interface{}{
+					"ami":                ami,
+					"region":             w.worker.Spec.Region,
+					"machineType":        instanceType,
+					"iamInstanceProfile": iamInstanceProfile,
+					"networkInterfaces": []map[string]interface{}{
+						{
+							"subnetID":         nodesSubnet.ID,
+							"securityGroupIDs": []string{nodesSecurityGroup.ID},
+						},
 					},
-				},
-				"tags": utils.MergeStringMaps(
-					map[string]string{
-						fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
-						"kubernetes.io/role/node":                                   "1",
+					"tags": utils.MergeStringMaps(
+						map[string]string{
+							fmt.Sprintf("kubernetes.io/cluster/%s", w.worker.Namespace): "1",
+							"kubernetes.io/role/node":                                   "1",
+						},
+						pool.Labels,
+					),
+					"credentialsSecretRef": map[string]interface{}{
+						"name":      w.worker.Spec.SecretRef.Name,
+						"namespace": w.worker.Spec.SecretRef.Namespace,
 					},
-					pool.Labels,
-				),
-				"credentialsSecretRef": map[string]interface{}{
-					"name":      w.worker.Spec.SecretRef.Name,
-					"namespace": w.worker.Spec.SecretRef.Namespace,
-				},
-				"secret": map[string]interface{}{
-					"cloudConfig": string(userData),
-				},
-				"blockDevices":            blockDevices,
-				"instanceMetadataOptions": instanceMetadataOptions,
-			}
+					"secret": map[string]interface{}{
+						"cloudConfig": string(userData),
+					},
+					"blockDevices":            blockDevices,
+					"instanceMetadataOptions": instanceMetadataOptions,
+				}
 
-			if len(infrastructureStatus.EC2.KeyName) > 0 {
-				machineClassSpec["keyName"] = infrastructureStatus.EC2.KeyName
-			}
+				if spotOptions != nil {
+					machineClassSpec["spotOptions"] = spotOptions
+				}
 
-			if workerConfig.NodeTemplate != nil {
-				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     workerConfig.NodeTemplate.Capacity,
-					InstanceType: pool.MachineType,
-					Region:       w.worker.Spec.Region,
-					Zone:         zone,
-					Architecture: &arch,
+				if opts := workerConfig.InstanceTypeOptions; opts != nil {
+					if opts.PurchaseOption != nil {
+						machineClassSpec["purchaseOption"] = string(*opts.PurchaseOption)
+					}
+					if opts.AllocationStrategy != nil {
+						machineClassSpec["allocationStrategy"] = string(*opts.AllocationStrategy)
+					}
 				}
-			} else if pool.NodeTemplate != nil {
-				machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
-					Capacity:     pool.NodeTemplate.Capacity,
-					InstanceType: pool.MachineType,
-					Region:       w.worker.Spec.Region,
-					Zone:         zone,
-					Architecture: &arch,
+
+				if workerConfig.PlacementGroup != nil {
+					machineClassSpec["placement"] = computePlacementGroupSpec(workerConfig.PlacementGroup)
 				}
-			}
 
-			if pool.MachineImage.Name != "" && pool.MachineImage.Version != "" {
-				machineClassSpec["operatingSystem"] = map[string]interface{}{
-					"operatingSystemName":    pool.MachineImage.Name,
-					"operatingSystemVersion": pool.MachineImage.Version,
+				if workerConfig.CapacityReservationTarget != nil {
+					machineClassSpec["capacityReservationSpecification"] = computeCapacityReservationSpec(workerConfig.CapacityReservationTarget)
 				}
-			}
 
-			var (
-				deploymentName          = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
-				className               = fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
-				awsCSIDriverTopologyKey = "topology.ebs.csi.aws.com/zone"
-			)
-
-			machineDeployments = append(machineDeployments, worker.MachineDeployment{
-				Name:           deploymentName,
-				ClassName:      className,
-				SecretName:     className,
-				Minimum:        worker.DistributeOverZones(zoneIdx, pool.Minimum, zoneLen),
-				Maximum:        worker.DistributeOverZones(zoneIdx, pool.Maximum, zoneLen),
-				MaxSurge:       worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxSurge, zoneLen, pool.Maximum),
-				MaxUnavailable: worker.DistributePositiveIntOrPercent(zoneIdx, pool.MaxUnavailable, zoneLen, pool.Minimum),
-				// TODO: remove the csi topology label when AWS CSI driver stops using the aws csi topology key - https://github.com/kubernetes-sigs/aws-ebs-csi-driver/issues/899
-				// add aws csi driver topology label if it's not specified
-				Labels:                       utils.MergeStringMaps(pool.Labels, map[string]string{awsCSIDriverTopologyKey: zone}),
-				Annotations:                  pool.Annotations,
-				Taints:                       pool.Taints,
-				MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
-				ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
-			})
+				if len(infrastructureStatus.EC2.KeyName) > 0 {
+					machineClassSpec["keyName"] = infrastructureStatus.EC2.KeyName
+				}
 
-			machineClassSpec["name"] = className
-			machineClassSpec["labels"] = map[string]string{corev1.LabelZoneFailureDomain: zone}
-			machineClassSpec["secret"].(map[string]interface{})["labels"] = map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}
+				if workerConfig.NodeTemplate != nil {
+					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
+						Capacity:     workerConfig.NodeTemplate.Capacity,
+						InstanceType: instanceType,
+						Region:       w.worker.Spec.Region,
+						Zone:         zone,
+						Architecture: &arch,
+					}
+				} else if pool.NodeTemplate != nil {
+					machineClassSpec["nodeTemplate"] = machinev1alpha1.NodeTemplate{
+						Capacity:     pool.NodeTemplate.Capacity,
+						InstanceType: instanceType,
+						Region:       w.worker.Spec.Region,
+						Zone:         zone,
+						Architecture: &arch,
+					}
+				}
+
+				if pool.MachineImage.Name != "" && pool.MachineImage.Version != "" {
+					machineClassSpec["operatingSystem"] = map[string]interface{}{
+						"operatingSystemName":    pool.MachineImage.Name,
+						"operatingSystemVersion": pool.MachineImage.Version,
+					}
+				}
 
-			machineClasses = append(machineClasses, machineClassSpec)
+				var (
+					deploymentName          = fmt.Sprintf("%s-%s-z%d", w.worker.Namespace, pool.Name, zoneIndex+1)
+					awsCSIDriverTopologyKey = "topology.ebs.csi.aws.com/zone"
+				)
+				if typeLen > 1 {
+					deploymentName = fmt.Sprintf("%s-%d", deploymentName, typeIndex)
+				}
+				className := fmt.Sprintf("%s-%s", deploymentName, workerPoolHash)
+
+				machineDeployments = append(machineDeployments, worker.MachineDeployment{
+					Name:           deploymentName,
+					ClassName:      className,
+					SecretName:     className,
+					Minimum:        distributeOverInstanceTypes(typeIdx, zoneMinimum, typeLen),
+					Maximum:        distributeOverInstanceTypes(typeIdx, zoneMaximum, typeLen),
+					MaxSurge:       worker.DistributePositiveIntOrPercent(typeIdx, zoneMaxSurge, typeLen, zoneMaximum),
+					MaxUnavailable: worker.DistributePositiveIntOrPercent(typeIdx, zoneMaxUnavailable, typeLen, zoneMinimum),
+					// TODO: remove the csi topology label when AWS CSI driver stops using the aws csi topology key - https://github.com/kubernetes-sigs/aws-ebs-csi-driver/issues/899
+					// add aws csi driver topology label if it's not specified
+					Labels:                       utils.MergeStringMaps(pool.Labels, map[string]string{awsCSIDriverTopologyKey: zone}),
+					Annotations:                  pool.Annotations,
+					Taints:                       pool.Taints,
+					MachineConfiguration:         genericworkeractuator.ReadMachineConfiguration(pool),
+					ClusterAutoscalerAnnotations: extensionsv1alpha1helper.GetMachineDeploymentClusterAutoscalerAnnotations(pool.ClusterAutoscaler),
+				})
+
+				machineClassSpec["name"] = className
+				machineClassSpec["labels"] = map[string]string{corev1.LabelZoneFailureDomain: zone}
+				machineClassSpec["secret"].(map[string]interface{})["labels"] = map[string]string{v1beta1constants.GardenerPurpose: v1beta1constants.GardenPurposeMachineClass}
+
+				machineClasses = append(machineClasses, machineClassSpec)
+			}
 		}
 	}
 
 	w.machineDeployments = machineDeployments
 	w.machineClasses = machineClasses
 	w.machineImages = machineImages
+	w.ec2NodeClasses = ec2NodeClasses
+	w.nodePools = nodePools
 
 	return nil
 }
 
-func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) ([]map[string]interface{}, error) {
+// computeBlockDevices computes the root and data disk block device mappings for a worker pool. instanceType
+// determines the data disk device-name prefix ("/dev/sd*" vs. the legacy "/dev/xvd*"), so callers must pass the
+// specific instance type the resulting block devices will be attached to, since a pool's alternative instance
+// type options (see InstanceTypeOptions) may mix legacy-Xen and Nitro families.
+func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig, instanceType string) ([]map[string]interface{}, error) {
 	var blockDevices []map[string]interface{}
 
 	// handle root disk
@@ -291,7 +372,7 @@ func (w *workerDelegate) computeBlockDevices(pool extensionsv1alpha1.WorkerPool,
 					dataDisk["throughput"] = *dvConfig.Throughput
 				}
 			}
-			deviceName, err := computeEBSDeviceNameForIndex(i)
+			deviceName, err := computeEBSDeviceNameForIndex(i, instanceType)
 			if err != nil {
 				return nil, fmt.Errorf("error when computing EBS device name for %v: %w", vol, err)
 			}
@@ -336,21 +417,60 @@ func computeEBS(size string, volumeType *string, encrypted *bool) (map[string]in
 	return ebs, nil
 }
 
-// AWS device naming https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/device_naming.html
-func computeEBSDeviceNameForIndex(index int) (string, error) {
-	var (
-		deviceNamePrefix = "/dev/sd"
-		deviceNameSuffix = "fghijklmnop"
-	)
+const (
+	// singleLetterDeviceNames is the AWS-recommended single-letter suffix range "/dev/sd[f-p]" for the first
+	// 11 data volumes attached to an instance.
+	singleLetterDeviceNames = "fghijklmnop"
+	// doubleLetterFirstChars is the first-character range "/dev/sd[b-c]*" used once the single-letter range is
+	// exhausted. "a" is skipped since it is reserved for the root device.
+	doubleLetterFirstChars = "bc"
+	// doubleLetterSecondChars is the second-character range "/dev/sd*[a-z]" combined with doubleLetterFirstChars.
+	doubleLetterSecondChars = "abcdefghijklmnopqrstuvwxyz"
+)
 
-	if index >= len(deviceNameSuffix) {
+// legacyXenInstanceFamilyPrefixes lists EC2 instance type prefixes of pre-Nitro, Xen-virtualized families that
+// expose EBS volumes as "/dev/xvd*" instead of the "/dev/sd*" naming used on Nitro/NVMe instances.
+var legacyXenInstanceFamilyPrefixes = []string{"t1.", "m1.", "m2.", "m3.", "c1.", "c3.", "cr1.", "hi1.", "hs1.", "r3."}
+
+// computeEBSDeviceNameForIndex computes the device name for the data volume at the given zero-based index.
+// It follows the AWS-recommended EC2 block device mapping scheme (https://docs.aws.amazon.com/AWSEC2/latest/UserGuide/device_naming.html):
+// the first 11 data volumes get single-letter suffixes "/dev/sd[f-p]", and any further volumes get two-letter
+// suffixes "/dev/sd[b-c][a-z]", for a total of 63 supported data volumes. Pre-Nitro, Xen-virtualized instance
+// families use the legacy "/dev/xvd*" prefix instead.
+func computeEBSDeviceNameForIndex(index int, instanceType string) (string, error) {
+	deviceNamePrefix := "/dev/sd"
+	if isLegacyXenInstanceFamily(instanceType) {
+		deviceNamePrefix = "/dev/xvd"
+	}
+
+	if index < len(singleLetterDeviceNames) {
+		return deviceNamePrefix + singleLetterDeviceNames[index:index+1], nil
+	}
+
+	doubleLetterIndex := index - len(singleLetterDeviceNames)
+	maxDoubleLetterIndex := len(doubleLetterFirstChars) * len(doubleLetterSecondChars)
+	if doubleLetterIndex >= maxDoubleLetterIndex {
 		return "", fmt.Errorf("unsupported data volume number")
 	}
 
-	return deviceNamePrefix + deviceNameSuffix[index:index+1], nil
+	firstChar := doubleLetterFirstChars[doubleLetterIndex/len(doubleLetterSecondChars)]
+	secondChar := doubleLetterSecondChars[doubleLetterIndex%len(doubleLetterSecondChars)]
+
+	return deviceNamePrefix + string(firstChar) + string(secondChar), nil
+}
+
+// isLegacyXenInstanceFamily returns true if the given EC2 instance type belongs to a pre-Nitro, Xen-virtualized
+// family that names EBS volumes "/dev/xvd*" rather than "/dev/sd*".
+func isLegacyXenInstanceFamily(instanceType string) bool {
+	for _, prefix := range legacyXenInstanceFamilyPrefixes {
+		if strings.HasPrefix(instanceType, prefix) {
+			return true
+		}
+	}
+	return false
 }
 
-func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool) []string {
+func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) []string {
 	var additionalData []string
 
 	if pool.Volume != nil && pool.Volume.Encrypted != nil {
@@ -369,9 +489,139 @@ func computeAdditionalHashData(pool extensionsv1alpha1.WorkerPool) []string {
 		}
 	}
 
+	if opts := workerConfig.InstanceTypeOptions; opts != nil {
+		additionalData = append(additionalData, opts.Types...)
+
+		if opts.PurchaseOption != nil {
+			additionalData = append(additionalData, string(*opts.PurchaseOption))
+		}
+
+		if opts.AllocationStrategy != nil {
+			additionalData = append(additionalData, string(*opts.AllocationStrategy))
+		}
+
+		if opts.SpotOptions != nil {
+			if opts.SpotOptions.MaxPrice != nil {
+				additionalData = append(additionalData, *opts.SpotOptions.MaxPrice)
+			}
+			if opts.SpotOptions.InterruptionBehavior != nil {
+				additionalData = append(additionalData, *opts.SpotOptions.InterruptionBehavior)
+			}
+		}
+	}
+
+	if pg := workerConfig.PlacementGroup; pg != nil {
+		additionalData = append(additionalData, pg.Name)
+
+		if pg.Strategy != nil {
+			additionalData = append(additionalData, string(*pg.Strategy))
+		}
+
+		if pg.Partitions != nil {
+			additionalData = append(additionalData, strconv.FormatInt(int64(*pg.Partitions), 10))
+		}
+	}
+
+	if crt := workerConfig.CapacityReservationTarget; crt != nil {
+		if crt.CapacityReservationID != nil {
+			additionalData = append(additionalData, *crt.CapacityReservationID)
+		}
+
+		if crt.CapacityReservationResourceGroupARN != nil {
+			additionalData = append(additionalData, *crt.CapacityReservationResourceGroupARN)
+		}
+
+		if crt.Preference != nil {
+			additionalData = append(additionalData, string(*crt.Preference))
+		}
+	}
+
 	return additionalData
 }
 
+// computeInstanceTypes returns the list of EC2 instance types a worker pool may be scheduled onto: the pool's
+// primary MachineType, followed by any alternatives configured via InstanceTypeOptions.
+func computeInstanceTypes(pool extensionsv1alpha1.WorkerPool, workerConfig *awsapi.WorkerConfig) []string {
+	instanceTypes := []string{pool.MachineType}
+
+	if workerConfig.InstanceTypeOptions != nil {
+		instanceTypes = append(instanceTypes, workerConfig.InstanceTypeOptions.Types...)
+	}
+
+	return instanceTypes
+}
+
+// distributeOverInstanceTypes splits a pool's min/max instance count across its alternative instance types,
+// assigning any remainder to the primary type (index 0) so MCM/CA always has a baseline type to fall back to.
+func distributeOverInstanceTypes(typeIndex, value, typeLen int32) int32 {
+	count := value / typeLen
+	if typeIndex == 0 {
+		count += value % typeLen
+	}
+	return count
+}
+
+// computeSpotOptions returns the spot market options for a worker pool's machine class, or nil if the pool is not
+// configured to use spot instances.
+func computeSpotOptions(workerConfig *awsapi.WorkerConfig) map[string]interface{} {
+	opts := workerConfig.InstanceTypeOptions
+	if opts == nil || opts.PurchaseOption == nil || *opts.PurchaseOption != awsapi.PurchaseOptionSpot {
+		return nil
+	}
+
+	spotOptions := map[string]interface{}{}
+
+	if opts.SpotOptions != nil {
+		if opts.SpotOptions.MaxPrice != nil {
+			spotOptions["maxPrice"] = *opts.SpotOptions.MaxPrice
+		}
+		if opts.SpotOptions.InterruptionBehavior != nil {
+			spotOptions["interruptionBehavior"] = *opts.SpotOptions.InterruptionBehavior
+		}
+	}
+
+	return spotOptions
+}
+
+// computePlacementGroupSpec returns the placement configuration for a worker pool's machine class.
+func computePlacementGroupSpec(placementGroup *awsapi.PlacementGroup) map[string]interface{} {
+	spec := map[string]interface{}{
+		"groupName": placementGroup.Name,
+	}
+
+	if placementGroup.Strategy != nil {
+		spec["strategy"] = string(*placementGroup.Strategy)
+	}
+
+	if placementGroup.Partitions != nil && placementGroup.Strategy != nil && *placementGroup.Strategy == awsapi.PlacementGroupStrategyPartition {
+		spec["partitionNumber"] = *placementGroup.Partitions
+	}
+
+	return spec
+}
+
+// computeCapacityReservationSpec returns the capacity reservation configuration for a worker pool's machine class.
+func computeCapacityReservationSpec(target *awsapi.CapacityReservationTarget) map[string]interface{} {
+	spec := map[string]interface{}{}
+
+	capacityReservationTarget := map[string]interface{}{}
+	if target.CapacityReservationID != nil {
+		capacityReservationTarget["capacityReservationId"] = *target.CapacityReservationID
+	}
+	if target.CapacityReservationResourceGroupARN != nil {
+		capacityReservationTarget["capacityReservationResourceGroupArn"] = *target.CapacityReservationResourceGroupARN
+	}
+	if len(capacityReservationTarget) > 0 {
+		spec["capacityReservationTarget"] = capacityReservationTarget
+	}
+
+	if target.Preference != nil {
+		spec["capacityReservationPreference"] = string(*target.Preference)
+	}
+
+	return spec
+}
+
 func computeIAMInstanceProfile(workerConfig *awsapi.WorkerConfig, infrastructureStatus *awsapi.InfrastructureStatus) (map[string]interface{}, error) {
 	if workerConfig.IAMInstanceProfile == nil {
 		nodesInstanceProfile, err := awsapihelper.FindInstanceProfileForPurpose(infrastructureStatus.IAM.InstanceProfiles, awsapi.PurposeNodes)
@@ -393,32 +643,96 @@ func computeIAMInstanceProfile(workerConfig *awsapi.WorkerConfig, infrastructure
 	return nil, fmt.Errorf("unable to compute IAM instance profile configuration")
 }
 
-// ComputeInstanceMetadata calculates the InstanceMetadata options for a particular worker pool.
-func ComputeInstanceMetadata(workerConfig *awsapi.WorkerConfig, cluster *controller.Cluster) (map[string]interface{}, error) {
+// ComputeInstanceMetadata calculates the InstanceMetadata options for a particular worker pool, applying the
+// extension-wide default IMDSv2 policy from workerDefaults unless the pool's own WorkerConfig overrides it.
+func ComputeInstanceMetadata(workerConfig *awsapi.WorkerConfig, pool extensionsv1alpha1.WorkerPool, cluster *controller.Cluster, workerDefaults *providerconfig.WorkerDefaults) (map[string]interface{}, error) {
 	res := make(map[string]interface{})
 
-	// apply new defaults for k8s >= v1.30 to require the use of IMDSv2, unless explicitly opted out.
-	if workerConfig == nil || workerConfig.InstanceMetadataOptions == nil {
-		k8sVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
-		if err != nil {
-			return nil, err
+	imd := instanceMetadataDefaults(workerDefaults)
+
+	if workerConfig != nil && workerConfig.InstanceMetadataOptions != nil {
+		if workerConfig.InstanceMetadataOptions.HTTPTokens != nil && *workerConfig.InstanceMetadataOptions.HTTPTokens == awsapi.HTTPTokensOptional &&
+			imd != nil && !isAllowedToOptOutOfInstanceMetadataDefaults(pool, imd) {
+			return nil, fmt.Errorf("worker pool %q may not set httpTokens to %q: the extension's IMDSv2 policy does not permit this pool to opt out", pool.Name, awsapi.HTTPTokensOptional)
 		}
 
-		if ConstraintK8sGreaterEqual130.Check(k8sVersion) {
-			res["httpPutResponseHopLimit"] = int64(2)
-			res["httpTokens"] = string(awsapi.HTTPTokensRequired)
+		if workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit != nil {
+			res["httpPutResponseHopLimit"] = *workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit
+		}
+
+		if workerConfig.InstanceMetadataOptions.HTTPTokens != nil {
+			res["httpTokens"] = string(*workerConfig.InstanceMetadataOptions.HTTPTokens)
 		}
 
 		return res, nil
 	}
 
-	if workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit != nil {
-		res["httpPutResponseHopLimit"] = *workerConfig.InstanceMetadataOptions.HTTPPutResponseHopLimit
+	// apply the default policy for k8s >= v1.30 to require the use of IMDSv2, unless workerDefaults configures
+	// a different constraint or values.
+	constraint := ConstraintK8sGreaterEqual130
+	hopLimit := int64(2)
+	httpTokens := awsapi.HTTPTokensRequired
+
+	if imd != nil {
+		if imd.MinKubernetesVersion != nil {
+			var err error
+			constraint, err = semver.NewConstraint(*imd.MinKubernetesVersion)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if imd.DefaultHopLimit != nil {
+			hopLimit = *imd.DefaultHopLimit
+		}
+		if imd.DefaultHTTPTokens != nil {
+			httpTokens = *imd.DefaultHTTPTokens
+		}
+	}
+
+	k8sVersion, err := semver.NewVersion(cluster.Shoot.Spec.Kubernetes.Version)
+	if err != nil {
+		return nil, err
 	}
 
-	if workerConfig.InstanceMetadataOptions.HTTPTokens != nil {
-		res["httpTokens"] = string(*workerConfig.InstanceMetadataOptions.HTTPTokens)
+	if constraint.Check(k8sVersion) {
+		res["httpPutResponseHopLimit"] = hopLimit
+		res["httpTokens"] = string(httpTokens)
 	}
 
 	return res, nil
 }
+
+// instanceMetadataDefaults returns the extension-wide IMDS default policy, or nil if none is configured.
+func instanceMetadataDefaults(workerDefaults *providerconfig.WorkerDefaults) *providerconfig.InstanceMetadataDefaults {
+	if workerDefaults == nil {
+		return nil
+	}
+	return workerDefaults.InstanceMetadata
+}
+
+// isAllowedToOptOutOfInstanceMetadataDefaults returns true if the given worker pool is matched by one of the
+// policy's AllowOptOutFor selectors.
+func isAllowedToOptOutOfInstanceMetadataDefaults(pool extensionsv1alpha1.WorkerPool, imd *providerconfig.InstanceMetadataDefaults) bool {
+	for _, selector := range imd.AllowOptOutFor {
+		if selector.PoolName != nil && *selector.PoolName == pool.Name {
+			return true
+		}
+
+		if len(selector.PoolLabels) == 0 {
+			continue
+		}
+
+		matchesAllLabels := true
+		for key, value := range selector.PoolLabels {
+			if pool.Labels[key] != value {
+				matchesAllLabels = false
+				break
+			}
+		}
+		if matchesAllLabels {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: 2024 SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import "testing"
+
+func TestComputeEBSDeviceNameForIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		index        int
+		instanceType string
+		want         string
+		wantErr      bool
+	}{
+		{name: "first single-letter device", index: 0, instanceType: "m5.large", want: "/dev/sdf"},
+		{name: "last single-letter device", index: 10, instanceType: "m5.large", want: "/dev/sdp"},
+		{name: "first double-letter device", index: 11, instanceType: "m5.large", want: "/dev/sdba"},
+		{name: "boundary into second double-letter group", index: 37, instanceType: "m5.large", want: "/dev/sdca"},
+		{name: "last supported device", index: 62, instanceType: "m5.large", want: "/dev/sdcz"},
+		{name: "beyond the upper limit", index: 63, instanceType: "m5.large", wantErr: true},
+		{name: "legacy Xen instance family uses xvd prefix", index: 0, instanceType: "m1.large", want: "/dev/xvdf"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := computeEBSDeviceNameForIndex(tt.index, tt.instanceType)
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("computeEBSDeviceNameForIndex(%d, %q) = %q, want error", tt.index, tt.instanceType, got)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("computeEBSDeviceNameForIndex(%d, %q) returned unexpected error: %v", tt.index, tt.instanceType, err)
+			}
+			if got != tt.want {
+				t.Errorf("computeEBSDeviceNameForIndex(%d, %q) = %q, want %q", tt.index, tt.instanceType, got, tt.want)
+			}
+		})
+	}
+}